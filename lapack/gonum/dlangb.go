@@ -0,0 +1,97 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlangb returns the given norm of an m×n band matrix with kl sub-diagonals
+// and ku super-diagonals.
+//
+// When norm is lapack.MaxColumnSum, the length of work must be at least n.
+func (impl Implementation) Dlangb(norm lapack.MatrixNorm, m, n, kl, ku int, ab []float64, ldab int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxAbs && norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius:
+		panic(badNorm)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(ab) < (m-1)*ldab+kl+ku+1:
+		panic(shortAB)
+	case len(work) < n && norm == lapack.MaxColumnSum:
+		panic(shortWork)
+	}
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for i := 0; i < m; i++ {
+			for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+				aij := math.Abs(ab[i*ldab+j-i+kl])
+				if aij > value || math.IsNaN(aij) {
+					value = aij
+				}
+			}
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < m; i++ {
+			var sum float64
+			for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+				sum += math.Abs(ab[i*ldab+j-i+kl])
+			}
+			if sum > value || math.IsNaN(sum) {
+				value = sum
+			}
+		}
+	case lapack.MaxColumnSum:
+		work = work[:n]
+		for j := range work {
+			work[j] = 0
+		}
+		for i := 0; i < m; i++ {
+			for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+				work[j] += math.Abs(ab[i*ldab+j-i+kl])
+			}
+		}
+		for _, wj := range work {
+			if wj > value || math.IsNaN(wj) {
+				value = wj
+			}
+		}
+	case lapack.Frobenius:
+		scale := 0.0
+		ssq := 1.0
+		for i := 0; i < m; i++ {
+			jlo := max(0, i-kl)
+			jhi := min(n-1, i+ku)
+			ilen := jhi - jlo + 1
+			if ilen <= 0 {
+				continue
+			}
+			rowscale, rowssq := impl.Dlassq(ilen, ab[i*ldab+jlo-i+kl:], 1, 0, 1)
+			scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
+		}
+		value = scale * math.Sqrt(ssq)
+	}
+	return value
+}
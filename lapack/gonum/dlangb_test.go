@@ -0,0 +1,109 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dlangbDense expands an m×n band matrix with kl sub-diagonals and ku
+// super-diagonals, stored in ab with leading dimension ldab, into a dense
+// m×n matrix. The band membership test (-kl <= j-i <= ku) is independent of
+// the clamped loop bounds used by Dlangb itself, so a shared off-by-one in
+// the band indexing would not go undetected.
+func dlangbDense(m, n, kl, ku int, ab []float64, ldab int) []float64 {
+	dense := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if j-i < -kl || j-i > ku {
+				continue
+			}
+			dense[i*n+j] = ab[i*ldab+j-i+kl]
+		}
+	}
+	return dense
+}
+
+// dlangbReferenceNorm computes the given norm of the dense m×n matrix dense.
+func dlangbReferenceNorm(norm lapack.MatrixNorm, m, n int, dense []float64) float64 {
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for _, v := range dense {
+			if av := math.Abs(v); av > value {
+				value = av
+			}
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < m; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				sum += math.Abs(dense[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			var sum float64
+			for i := 0; i < m; i++ {
+				sum += math.Abs(dense[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	case lapack.Frobenius:
+		var sum float64
+		for _, v := range dense {
+			sum += v * v
+		}
+		value = math.Sqrt(sum)
+	}
+	return value
+}
+
+func TestDlangb(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	norms := []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxRowSum, lapack.MaxColumnSum, lapack.Frobenius}
+	for _, dims := range [][2]int{{1, 1}, {2, 3}, {3, 2}, {4, 4}, {10, 7}} {
+		m, n := dims[0], dims[1]
+		for _, kl := range []int{0, 1, 2, m - 1} {
+			if kl < 0 || kl >= m {
+				continue
+			}
+			for _, ku := range []int{0, 1, 2, n - 1} {
+				if ku < 0 || ku >= n {
+					continue
+				}
+				for _, norm := range norms {
+					ldab := kl + ku + 1
+					ab := make([]float64, m*ldab)
+					for i := range ab {
+						ab[i] = rnd.NormFloat64()
+					}
+					work := make([]float64, n)
+
+					impl := Implementation{}
+					got := impl.Dlangb(norm, m, n, kl, ku, ab, ldab, work)
+
+					dense := dlangbDense(m, n, kl, ku, ab, ldab)
+					want := dlangbReferenceNorm(norm, m, n, dense)
+
+					if diff := math.Abs(got - want); diff > 1e-10*math.Max(1, want) {
+						t.Errorf("m=%d n=%d kl=%d ku=%d norm=%v: got %v, want %v",
+							m, n, kl, ku, norm, got, want)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlansb returns the given norm of an n×n symmetric band matrix with k
+// super-diagonals (if uplo == blas.Upper) or k sub-diagonals (if
+// uplo == blas.Lower).
+//
+// When norm is lapack.MaxRowSum or lapack.MaxColumnSum, the length of work
+// must be at least n.
+func (impl Implementation) Dlansb(norm lapack.MatrixNorm, uplo blas.Uplo, n, k int, ab []float64, ldab int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxAbs && norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kdLT0)
+	case ldab < k+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+k+1:
+		panic(shortAB)
+	case len(work) < n && (norm == lapack.MaxRowSum || norm == lapack.MaxColumnSum):
+		panic(shortWork)
+	}
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		if uplo == blas.Upper {
+			for i := 0; i < n; i++ {
+				for j := 0; j < min(n-i, k+1); j++ {
+					aij := math.Abs(ab[i*ldab+j])
+					if aij > value || math.IsNaN(aij) {
+						value = aij
+					}
+				}
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				for j := max(0, k-i); j < k+1; j++ {
+					aij := math.Abs(ab[i*ldab+j])
+					if aij > value || math.IsNaN(aij) {
+						value = aij
+					}
+				}
+			}
+		}
+	case lapack.MaxRowSum, lapack.MaxColumnSum:
+		// The 1-norm and the infinity-norm of a symmetric matrix are equal,
+		// so both are computed as the maximum column sum.
+		work = work[:n]
+		for i := range work {
+			work[i] = 0
+		}
+		if uplo == blas.Upper {
+			for i := 0; i < n; i++ {
+				sum := work[i] + math.Abs(ab[i*ldab])
+				for j := 1; j < min(n-i, k+1); j++ {
+					aij := math.Abs(ab[i*ldab+j])
+					sum += aij
+					work[i+j] += aij
+				}
+				work[i] = sum
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				sum := work[i] + math.Abs(ab[i*ldab+k])
+				for j := max(0, k-i); j < k; j++ {
+					aij := math.Abs(ab[i*ldab+j])
+					sum += aij
+					work[i+j-k] += aij
+				}
+				work[i] = sum
+			}
+		}
+		for _, wi := range work {
+			if wi > value || math.IsNaN(wi) {
+				value = wi
+			}
+		}
+	case lapack.Frobenius:
+		scale := 0.0
+		ssq := 1.0
+		if uplo == blas.Upper {
+			if k > 0 {
+				for i := 0; i < n-1; i++ {
+					ilen := min(n-i-1, k)
+					rowscale, rowssq := impl.Dlassq(ilen, ab[i*ldab+1:], 1, 0, 1)
+					scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
+				}
+				ssq *= 2
+			}
+			dscale, dssq := impl.Dlassq(n, ab, ldab, 0, 1)
+			scale, ssq = impl.Dcombssq(scale, ssq, dscale, dssq)
+		} else {
+			if k > 0 {
+				for i := 1; i < n; i++ {
+					ilen := min(i, k)
+					rowscale, rowssq := impl.Dlassq(ilen, ab[i*ldab+k-ilen:], 1, 0, 1)
+					scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
+				}
+				ssq *= 2
+			}
+			dscale, dssq := impl.Dlassq(n, ab[k:], ldab, 0, 1)
+			scale, ssq = impl.Dcombssq(scale, ssq, dscale, dssq)
+		}
+		value = scale * math.Sqrt(ssq)
+	}
+	return value
+}
@@ -0,0 +1,83 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dlansbDense expands an n×n symmetric band matrix with k super-diagonals
+// (uplo == blas.Upper) or k sub-diagonals (uplo == blas.Lower), stored in ab
+// with leading dimension ldab, into a dense symmetric n×n matrix. The band
+// membership test is expressed directly in terms of |i-j| rather than the
+// clamped loop bounds used by Dlansb itself, so a shared off-by-one in the
+// band indexing would not go undetected.
+func dlansbDense(uplo blas.Uplo, n, k int, ab []float64, ldab int) []float64 {
+	dense := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			d := j - i
+			if d < -k || d > k {
+				continue
+			}
+			var v float64
+			if uplo == blas.Upper {
+				if d >= 0 {
+					v = ab[i*ldab+d]
+				} else {
+					v = ab[j*ldab-d]
+				}
+			} else {
+				if d <= 0 {
+					v = ab[i*ldab+k+d]
+				} else {
+					v = ab[j*ldab+k-d]
+				}
+			}
+			dense[i*n+j] = v
+		}
+	}
+	return dense
+}
+
+func TestDlansb(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	norms := []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxRowSum, lapack.MaxColumnSum, lapack.Frobenius}
+	uplos := []blas.Uplo{blas.Upper, blas.Lower}
+	for _, n := range []int{1, 2, 3, 4, 10} {
+		for _, k := range []int{0, 1, 2, n - 1} {
+			if k < 0 || k >= n+1 {
+				continue
+			}
+			for _, uplo := range uplos {
+				for _, norm := range norms {
+					const ldab = 20
+					ab := make([]float64, n*ldab)
+					for i := range ab {
+						ab[i] = rnd.NormFloat64()
+					}
+					work := make([]float64, n)
+
+					impl := Implementation{}
+					got := impl.Dlansb(norm, uplo, n, k, ab, ldab, work)
+
+					dense := dlansbDense(uplo, n, k, ab, ldab)
+					want := dlangbReferenceNorm(norm, n, n, dense)
+
+					if diff := math.Abs(got - want); diff > 1e-10*math.Max(1, want) {
+						t.Errorf("n=%d k=%d uplo=%v norm=%v: got %v, want %v",
+							n, k, uplo, norm, got, want)
+					}
+				}
+			}
+		}
+	}
+}
@@ -126,7 +126,6 @@ func (impl Implementation) Dlantb(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 		}
 	case lapack.MaxColumnSum:
 		work = work[:n]
-		var sum float64
 		if uplo == blas.Upper {
 			if diag == blas.Unit {
 				for i := range work {
@@ -153,7 +152,7 @@ func (impl Implementation) Dlantb(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 					work[i] = 1
 				}
 				for i := 0; i < n; i++ {
-					for j := max(0, k-i); j < k+1; j++ {
+					for j := max(0, k-i); j < k; j++ {
 						work[i+j-k] += math.Abs(a[i*lda+j])
 					}
 				}
@@ -175,34 +174,41 @@ func (impl Implementation) Dlantb(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 			}
 		}
 	case lapack.Frobenius:
-		scale := 0.0
-		ssq := 1.0
+		var scale, ssq float64
+		if diag == blas.Unit {
+			// The diagonal is all ones and is not stored.
+			scale, ssq = 1, float64(n)
+		} else {
+			scale, ssq = 0, 1
+		}
 		if uplo == blas.Upper {
-			if kd > 0 {
+			if k > 0 {
 				// Sum off-diagonals.
 				for i := 0; i < n-1; i++ {
-					ilen := min(n-i-1, kd)
-					rowscale, rowssq := impl.Dlassq(ilen, ab[i*ldab+1:], 1, 0, 1)
+					ilen := min(n-i-1, k)
+					rowscale, rowssq := impl.Dlassq(ilen, a[i*lda+1:], 1, 0, 1)
 					scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
 				}
-				ssq *= 2
 			}
-			// Sum diagonal.
-			dscale, dssq := impl.Dlassq(n, ab, ldab, 0, 1)
-			scale, ssq = impl.Dcombssq(scale, ssq, dscale, dssq)
+			if diag == blas.NonUnit {
+				// Sum diagonal.
+				dscale, dssq := impl.Dlassq(n, a, lda, 0, 1)
+				scale, ssq = impl.Dcombssq(scale, ssq, dscale, dssq)
+			}
 		} else {
-			if kd > 0 {
+			if k > 0 {
 				// Sum off-diagonals.
 				for i := 1; i < n; i++ {
-					ilen := min(i, kd)
-					rowscale, rowssq := impl.Dlassq(ilen, ab[i*ldab+kd-ilen:], 1, 0, 1)
+					ilen := min(i, k)
+					rowscale, rowssq := impl.Dlassq(ilen, a[i*lda+k-ilen:], 1, 0, 1)
 					scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
 				}
-				ssq *= 2
 			}
-			// Sum diagonal.
-			dscale, dssq := impl.Dlassq(n, ab[kd:], ldab, 0, 1)
-			scale, ssq = impl.Dcombssq(scale, ssq, dscale, dssq)
+			if diag == blas.NonUnit {
+				// Sum diagonal.
+				dscale, dssq := impl.Dlassq(n, a[k:], lda, 0, 1)
+				scale, ssq = impl.Dcombssq(scale, ssq, dscale, dssq)
+			}
 		}
 		value = scale * math.Sqrt(ssq)
 	}
@@ -0,0 +1,120 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dlantbDense expands a triangular band matrix stored in a with leading
+// dimension lda into a dense n×n matrix and returns the given norm computed
+// directly from the dense representation. It serves as a reference
+// implementation for TestDlantb, playing the role that Dlantr would if it
+// were available in this package. The band membership test is expressed
+// directly in terms of i-j rather than the clamped loop bounds used by
+// Dlantb itself, so a shared off-by-one in the band indexing would not go
+// undetected.
+func dlantbDense(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n, k int, a []float64, lda int) float64 {
+	dense := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			d := j - i
+			if uplo == blas.Upper {
+				if d < 0 || d > k {
+					continue
+				}
+				dense[i*n+j] = a[i*lda+d]
+			} else {
+				if d > 0 || -d > k {
+					continue
+				}
+				dense[i*n+j] = a[i*lda+d+k]
+			}
+		}
+	}
+	if diag == blas.Unit {
+		for i := 0; i < n; i++ {
+			dense[i*n+i] = 1
+		}
+	}
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for _, v := range dense {
+			if av := math.Abs(v); av > value {
+				value = av
+			}
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < n; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				sum += math.Abs(dense[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			var sum float64
+			for i := 0; i < n; i++ {
+				sum += math.Abs(dense[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	case lapack.Frobenius:
+		var sum float64
+		for _, v := range dense {
+			sum += v * v
+		}
+		value = math.Sqrt(sum)
+	}
+	return value
+}
+
+func TestDlantb(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	norms := []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxRowSum, lapack.MaxColumnSum, lapack.Frobenius}
+	uplos := []blas.Uplo{blas.Upper, blas.Lower}
+	diags := []blas.Diag{blas.NonUnit, blas.Unit}
+	for _, n := range []int{1, 2, 3, 4, 10} {
+		for _, k := range []int{0, 1, 2, n - 1} {
+			if k < 0 || k >= n+1 {
+				continue
+			}
+			for _, uplo := range uplos {
+				for _, diag := range diags {
+					for _, norm := range norms {
+						const lda = 20
+						a := make([]float64, n*lda)
+						for i := range a {
+							a[i] = rnd.NormFloat64()
+						}
+						work := make([]float64, n)
+
+						impl := Implementation{}
+						got := impl.Dlantb(norm, uplo, diag, n, k, a, lda, work)
+						want := dlantbDense(norm, uplo, diag, n, k, a, lda)
+
+						if diff := math.Abs(got - want); diff > 1e-10*math.Max(1, want) {
+							t.Errorf("n=%d k=%d uplo=%v diag=%v norm=%v: got %v, want %v",
+								n, k, uplo, diag, norm, got, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtbcon estimates the reciprocal of the condition number of an n×n
+// triangular band matrix A with kd+1 diagonals, in either the 1-norm or the
+// infinity-norm.
+//
+// The reciprocal of the condition number is computed as
+//
+//	rcond = 1 / (norm(A) * norm(inv(A)))
+//
+// where norm(A) is computed directly from the band storage using Dlantb, and
+// norm(inv(A)) is estimated using Dlacn2 together with repeated triangular
+// band solves via Dtbsv.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dtbcon will panic.
+func (impl Implementation) Dtbcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n, kd int, ab []float64, ldab int, work []float64, iwork []int) (float64, []int) {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(badDiag)
+	case n < 0:
+		panic(nLT0)
+	case kd < 0:
+		panic(kdLT0)
+	case ldab < kd+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1, iwork
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(shortAB)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// Quick return if A is singular because of a zero diagonal element.
+	if diag == blas.NonUnit {
+		if uplo == blas.Upper {
+			for i := 0; i < n; i++ {
+				if ab[i*ldab] == 0 {
+					return 0, iwork
+				}
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				if ab[i*ldab+kd] == 0 {
+					return 0, iwork
+				}
+			}
+		}
+	}
+
+	anorm := impl.Dlantb(norm, uplo, diag, n, kd, ab, ldab, work)
+	if anorm == 0 {
+		return 0, iwork
+	}
+
+	// Estimate norm(inv(A)).
+	bi := blas64.Implementation()
+	x := work[:n]
+	var (
+		ainvnm float64
+		kase   int
+		isave  [3]int
+	)
+	for {
+		ainvnm, kase = impl.Dlacn2(n, work[n:2*n], x, iwork, ainvnm, kase, &isave)
+		if kase == 0 {
+			break
+		}
+		// kase == 1 estimates A*x = b, kase == 2 estimates A^T*x = b; the
+		// meaning of "transpose" swaps depending on which norm is being
+		// estimated.
+		trans := blas.NoTrans
+		if (kase == 1) != (norm == lapack.MaxColumnSum) {
+			trans = blas.Trans
+		}
+		bi.Dtbsv(uplo, trans, diag, n, kd, ab, ldab, x, 1)
+	}
+
+	var rcond float64
+	if ainvnm != 0 {
+		rcond = (1 / anorm) / ainvnm
+	}
+	return rcond, iwork
+}
@@ -0,0 +1,204 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dtbconDense expands a triangular band matrix stored in ab with leading
+// dimension ldab into a dense n×n matrix.
+func dtbconDense(uplo blas.Uplo, diag blas.Diag, n, k int, ab []float64, ldab int) []float64 {
+	dense := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if uplo == blas.Upper {
+				if j >= i && j-i <= k {
+					dense[i*n+j] = ab[i*ldab+(j-i)]
+				}
+			} else {
+				if j <= i && i-j <= k {
+					dense[i*n+j] = ab[i*ldab+(j-i+k)]
+				}
+			}
+		}
+	}
+	if diag == blas.Unit {
+		for i := 0; i < n; i++ {
+			dense[i*n+i] = 1
+		}
+	}
+	return dense
+}
+
+// denseNorm computes the 1-norm or infinity-norm of a dense n×n matrix.
+func denseNorm(norm lapack.MatrixNorm, n int, a []float64) float64 {
+	var value float64
+	if norm == lapack.MaxRowSum {
+		for i := 0; i < n; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				sum += math.Abs(a[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	} else {
+		for j := 0; j < n; j++ {
+			var sum float64
+			for i := 0; i < n; i++ {
+				sum += math.Abs(a[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	}
+	return value
+}
+
+// denseInverse inverts the dense n×n matrix a by Gauss-Jordan elimination
+// with partial pivoting. It returns nil if a is numerically singular.
+func denseInverse(n int, a []float64) []float64 {
+	aug := make([]float64, n*2*n)
+	for i := 0; i < n; i++ {
+		copy(aug[i*2*n:i*2*n+n], a[i*n:i*n+n])
+		aug[i*2*n+n+i] = 1
+	}
+	for col := 0; col < n; col++ {
+		piv := col
+		best := math.Abs(aug[col*2*n+col])
+		for r := col + 1; r < n; r++ {
+			if v := math.Abs(aug[r*2*n+col]); v > best {
+				best = v
+				piv = r
+			}
+		}
+		if best < 1e-13 {
+			return nil
+		}
+		if piv != col {
+			for c := 0; c < 2*n; c++ {
+				aug[col*2*n+c], aug[piv*2*n+c] = aug[piv*2*n+c], aug[col*2*n+c]
+			}
+		}
+		pivVal := aug[col*2*n+col]
+		for c := 0; c < 2*n; c++ {
+			aug[col*2*n+c] /= pivVal
+		}
+		for r := 0; r < n; r++ {
+			if r == col {
+				continue
+			}
+			factor := aug[r*2*n+col]
+			if factor == 0 {
+				continue
+			}
+			for c := 0; c < 2*n; c++ {
+				aug[r*2*n+c] -= factor * aug[col*2*n+c]
+			}
+		}
+	}
+	inv := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		copy(inv[i*n:i*n+n], aug[i*2*n+n:i*2*n+2*n])
+	}
+	return inv
+}
+
+func TestDtbcon(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	norms := []lapack.MatrixNorm{lapack.MaxRowSum, lapack.MaxColumnSum}
+	uplos := []blas.Uplo{blas.Upper, blas.Lower}
+	diags := []blas.Diag{blas.NonUnit, blas.Unit}
+	for _, n := range []int{1, 2, 3, 5} {
+		for _, k := range []int{0, 1, 2, n} {
+			if k < 0 || k > n {
+				continue
+			}
+			for _, uplo := range uplos {
+				for _, diag := range diags {
+					for _, norm := range norms {
+						const ldab = 20
+						ab := make([]float64, n*ldab)
+						for i := range ab {
+							ab[i] = rnd.NormFloat64()
+						}
+						if diag == blas.NonUnit {
+							// Bias the diagonal away from zero so that the
+							// matrix is well-conditioned.
+							for i := 0; i < n; i++ {
+								if uplo == blas.Upper {
+									ab[i*ldab] += 5
+								} else {
+									ab[i*ldab+k] += 5
+								}
+							}
+						}
+
+						impl := Implementation{}
+						work := make([]float64, 3*n)
+						iwork := make([]int, n)
+						got, _ := impl.Dtbcon(norm, uplo, diag, n, k, ab, ldab, work, iwork)
+
+						dense := dtbconDense(uplo, diag, n, k, ab, ldab)
+						inv := denseInverse(n, dense)
+						if inv == nil {
+							t.Fatalf("unexpected singular matrix for n=%d k=%d uplo=%v diag=%v", n, k, uplo, diag)
+						}
+						anorm := denseNorm(norm, n, dense)
+						ainvnorm := denseNorm(norm, n, inv)
+						want := 1 / (anorm * ainvnorm)
+
+						// Dlacn2 only estimates norm(inv(A)); Higham and
+						// Hager's analysis guarantees the estimate is within
+						// a factor of n of the true value, so rcond lies in
+						// [want, n*want] rather than equaling it exactly.
+						const slack = 1e-9
+						if got < want-slack || got > float64(n)*want+slack {
+							t.Errorf("n=%d k=%d uplo=%v diag=%v norm=%v: got rcond %v, want in [%v, %v]",
+								n, k, uplo, diag, norm, got, want, float64(n)*want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDtbconSingular(t *testing.T) {
+	t.Parallel()
+	const n, k, ldab = 4, 1, 20
+	ab := make([]float64, n*ldab)
+	for i := range ab {
+		ab[i] = 1
+	}
+	// Zero out a diagonal entry to force singularity.
+	ab[2*ldab] = 0
+
+	impl := Implementation{}
+	work := make([]float64, 3*n)
+	iwork := make([]int, n)
+	got, _ := impl.Dtbcon(lapack.MaxRowSum, blas.Upper, blas.NonUnit, n, k, ab, ldab, work, iwork)
+	if got != 0 {
+		t.Errorf("singular matrix: got rcond %v, want 0", got)
+	}
+}
+
+func TestDtbconZeroN(t *testing.T) {
+	t.Parallel()
+	impl := Implementation{}
+	got, _ := impl.Dtbcon(lapack.MaxRowSum, blas.Upper, blas.NonUnit, 0, 0, nil, 1, nil, nil)
+	if got != 1 {
+		t.Errorf("n=0: got rcond %v, want 1", got)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlanhb returns the given norm of an n×n Hermitian band matrix with k
+// super-diagonals (if uplo == blas.Upper) or k sub-diagonals (if
+// uplo == blas.Lower).
+//
+// When norm is lapack.MaxRowSum or lapack.MaxColumnSum, the length of work
+// must be at least n.
+func (impl Implementation) Zlanhb(norm lapack.MatrixNorm, uplo blas.Uplo, n, k int, ab []complex128, ldab int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxAbs && norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kdLT0)
+	case ldab < k+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+k+1:
+		panic(shortAB)
+	case len(work) < n && (norm == lapack.MaxRowSum || norm == lapack.MaxColumnSum):
+		panic(shortWork)
+	}
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		// The diagonal of a Hermitian matrix is real, so only its real part
+		// is considered.
+		if uplo == blas.Upper {
+			for i := 0; i < n; i++ {
+				for j := 1; j < min(n-i, k+1); j++ {
+					aij := cmplx.Abs(ab[i*ldab+j])
+					if aij > value || math.IsNaN(aij) {
+						value = aij
+					}
+				}
+				aii := math.Abs(real(ab[i*ldab]))
+				if aii > value || math.IsNaN(aii) {
+					value = aii
+				}
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				for j := max(0, k-i); j < k; j++ {
+					aij := cmplx.Abs(ab[i*ldab+j])
+					if aij > value || math.IsNaN(aij) {
+						value = aij
+					}
+				}
+				aii := math.Abs(real(ab[i*ldab+k]))
+				if aii > value || math.IsNaN(aii) {
+					value = aii
+				}
+			}
+		}
+	case lapack.MaxRowSum, lapack.MaxColumnSum:
+		// The 1-norm and the infinity-norm of a Hermitian matrix are equal,
+		// so both are computed as the maximum column sum.
+		work = work[:n]
+		for i := range work {
+			work[i] = 0
+		}
+		if uplo == blas.Upper {
+			for i := 0; i < n; i++ {
+				sum := work[i] + math.Abs(real(ab[i*ldab]))
+				for j := 1; j < min(n-i, k+1); j++ {
+					aij := cmplx.Abs(ab[i*ldab+j])
+					sum += aij
+					work[i+j] += aij
+				}
+				work[i] = sum
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				sum := work[i] + math.Abs(real(ab[i*ldab+k]))
+				for j := max(0, k-i); j < k; j++ {
+					aij := cmplx.Abs(ab[i*ldab+j])
+					sum += aij
+					work[i+j-k] += aij
+				}
+				work[i] = sum
+			}
+		}
+		for _, wi := range work {
+			if wi > value || math.IsNaN(wi) {
+				value = wi
+			}
+		}
+	case lapack.Frobenius:
+		scale := 0.0
+		ssq := 1.0
+		diagOff := 0
+		if uplo == blas.Lower {
+			diagOff = k
+		}
+		if k > 0 {
+			if uplo == blas.Upper {
+				for i := 0; i < n-1; i++ {
+					ilen := min(n-i-1, k)
+					rowscale, rowssq := impl.Zlassq(ilen, ab[i*ldab+1:], 1, 0, 1)
+					scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
+				}
+			} else {
+				for i := 1; i < n; i++ {
+					ilen := min(i, k)
+					rowscale, rowssq := impl.Zlassq(ilen, ab[i*ldab+k-ilen:], 1, 0, 1)
+					scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
+				}
+			}
+			ssq *= 2
+		}
+		// The diagonal of a Hermitian matrix is real; accumulate it
+		// separately using only the real part of each stored element.
+		var diag [1]float64
+		for i := 0; i < n; i++ {
+			diag[0] = math.Abs(real(ab[i*ldab+diagOff]))
+			if diag[0] == 0 {
+				continue
+			}
+			rowscale, rowssq := impl.Dlassq(1, diag[:], 1, 0, 1)
+			scale, ssq = impl.Dcombssq(scale, ssq, rowscale, rowssq)
+		}
+		value = scale * math.Sqrt(ssq)
+	}
+	return value
+}
@@ -0,0 +1,140 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// zlanhbDense expands an n×n Hermitian band matrix with k super-diagonals
+// (uplo == blas.Upper) or k sub-diagonals (uplo == blas.Lower), stored in ab
+// with leading dimension ldab, into a dense Hermitian n×n matrix. The band
+// membership test is expressed directly in terms of |i-j| rather than the
+// clamped loop bounds used by Zlanhb itself, so a shared off-by-one in the
+// band indexing would not go undetected.
+func zlanhbDense(uplo blas.Uplo, n, k int, ab []complex128, ldab int) []complex128 {
+	dense := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			d := j - i
+			if d < -k || d > k {
+				continue
+			}
+			var v complex128
+			switch {
+			case d == 0:
+				if uplo == blas.Upper {
+					v = complex(real(ab[i*ldab]), 0)
+				} else {
+					v = complex(real(ab[i*ldab+k]), 0)
+				}
+			case d > 0:
+				if uplo == blas.Upper {
+					v = ab[i*ldab+d]
+				} else {
+					v = cmplx.Conj(ab[j*ldab+k-d])
+				}
+			default:
+				if uplo == blas.Upper {
+					v = cmplx.Conj(ab[j*ldab-d])
+				} else {
+					v = ab[i*ldab+k+d]
+				}
+			}
+			dense[i*n+j] = v
+		}
+	}
+	return dense
+}
+
+// zlanhbReferenceNorm computes the given norm of the dense n×n matrix dense.
+func zlanhbReferenceNorm(norm lapack.MatrixNorm, n int, dense []complex128) float64 {
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for _, v := range dense {
+			if av := cmplx.Abs(v); av > value {
+				value = av
+			}
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < n; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				sum += cmplx.Abs(dense[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			var sum float64
+			for i := 0; i < n; i++ {
+				sum += cmplx.Abs(dense[i*n+j])
+			}
+			if sum > value {
+				value = sum
+			}
+		}
+	case lapack.Frobenius:
+		var sum float64
+		for _, v := range dense {
+			av := cmplx.Abs(v)
+			sum += av * av
+		}
+		value = math.Sqrt(sum)
+	}
+	return value
+}
+
+func TestZlanhb(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	norms := []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxRowSum, lapack.MaxColumnSum, lapack.Frobenius}
+	uplos := []blas.Uplo{blas.Upper, blas.Lower}
+	for _, n := range []int{1, 2, 3, 4, 10} {
+		for _, k := range []int{0, 1, 2, n - 1} {
+			if k < 0 || k >= n+1 {
+				continue
+			}
+			for _, uplo := range uplos {
+				for _, norm := range norms {
+					const ldab = 20
+					ab := make([]complex128, n*ldab)
+					for i := range ab {
+						ab[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+					}
+					// The diagonal of a Hermitian matrix is real.
+					for i := 0; i < n; i++ {
+						if uplo == blas.Upper {
+							ab[i*ldab] = complex(real(ab[i*ldab]), 0)
+						} else {
+							ab[i*ldab+k] = complex(real(ab[i*ldab+k]), 0)
+						}
+					}
+					work := make([]float64, n)
+
+					impl := Implementation{}
+					got := impl.Zlanhb(norm, uplo, n, k, ab, ldab, work)
+
+					dense := zlanhbDense(uplo, n, k, ab, ldab)
+					want := zlanhbReferenceNorm(norm, n, dense)
+
+					if diff := math.Abs(got - want); diff > 1e-10*math.Max(1, want) {
+						t.Errorf("n=%d k=%d uplo=%v norm=%v: got %v, want %v",
+							n, k, uplo, norm, got, want)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright ©2024 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Zlassq returns the values scl and smsq such that
+//
+//	scl^2*smsq = x[0]^2 + x[1]^2 + ... + x[(n-1)*incx]^2 + scale^2*sumsq
+//
+// where x is a complex vector and the sum of squares is computed using the
+// real and imaginary parts of each selected element. scale and sumsq provide
+// the initial scaling factor and sum of squares so that several calls can be
+// chained together to accumulate the sum of squares of more than one vector
+// without overflow.
+//
+// Zlassq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlassq(n int, x []complex128, incx int, scale, sumsq float64) (scl, smsq float64) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incx <= 0:
+		panic(badIncX)
+	case len(x) < 1+(n-1)*incx:
+		panic(shortX)
+	}
+
+	if n == 0 {
+		return scale, sumsq
+	}
+
+	for ix := 0; ix < n; ix++ {
+		v := x[ix*incx]
+		if re := real(v); re != 0 {
+			are := math.Abs(re)
+			if scale < are {
+				sumsq = 1 + sumsq*(scale/are)*(scale/are)
+				scale = are
+			} else {
+				sumsq += (are / scale) * (are / scale)
+			}
+		}
+		if im := imag(v); im != 0 {
+			aim := math.Abs(im)
+			if scale < aim {
+				sumsq = 1 + sumsq*(scale/aim)*(scale/aim)
+				scale = aim
+			} else {
+				sumsq += (aim / scale) * (aim / scale)
+			}
+		}
+	}
+	return scale, sumsq
+}